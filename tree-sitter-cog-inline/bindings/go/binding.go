@@ -0,0 +1,16 @@
+// Package cog_inline provides the tree-sitter-cog-inline grammar for the
+// official github.com/tree-sitter/go-tree-sitter runtime. It parses the
+// expression embedded in a single Cog string interpolation; see
+// tree-sitter-cog's queries/injections.scm for where it's routed from.
+package cog_inline
+
+// #cgo CFLAGS: -std=c11 -fPIC
+// #include "../../src/parser.c"
+import "C"
+
+import "unsafe"
+
+// Language returns the tree-sitter language for Cog's inline sub-grammar.
+func Language() unsafe.Pointer {
+	return unsafe.Pointer(C.tree_sitter_cog_inline())
+}
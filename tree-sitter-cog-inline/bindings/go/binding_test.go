@@ -0,0 +1,32 @@
+package cog_inline_test
+
+import (
+	"testing"
+
+	sitter "github.com/tree-sitter/go-tree-sitter"
+
+	cog_inline "github.com/tree-sitter/tree-sitter-cog-inline/bindings/go"
+)
+
+func TestCanLoadGrammar(t *testing.T) {
+	language := sitter.NewLanguage(cog_inline.Language())
+	if language == nil {
+		t.Errorf("Error loading Cog inline grammar")
+	}
+}
+
+func TestParsesEmbeddedExpression(t *testing.T) {
+	parser := sitter.NewParser()
+	defer parser.Close()
+	if err := parser.SetLanguage(sitter.NewLanguage(cog_inline.Language())); err != nil {
+		t.Fatal(err)
+	}
+
+	tree := parser.Parse([]byte("add(a, 1)"), nil)
+	defer tree.Close()
+
+	want := "(source_file (call_expression function: (identifier) (identifier) (number_literal)))"
+	if got := tree.RootNode().ToSexp(); got != want {
+		t.Errorf("tree mismatch:\n  want: %s\n  got:  %s", want, got)
+	}
+}
@@ -0,0 +1,16 @@
+// Package tree_sitter_cog is a compatibility shim for consumers still on
+// the github.com/smacker/go-tree-sitter runtime. New code should depend on
+// the bindings/go package instead, which targets the official
+// github.com/tree-sitter/go-tree-sitter runtime.
+package tree_sitter_cog
+
+// #cgo CFLAGS: -std=c11 -fPIC
+// #include "src/parser.c"
+import "C"
+
+import "unsafe"
+
+// Language returns the tree-sitter language for Cog.
+func Language() unsafe.Pointer {
+	return unsafe.Pointer(C.tree_sitter_cog())
+}
@@ -0,0 +1,15 @@
+// Package tree_sitter_cog provides the Cog grammar for the official
+// github.com/tree-sitter/go-tree-sitter runtime.
+package tree_sitter_cog
+
+// #cgo CFLAGS: -std=c11 -fPIC
+// #include "../../src/parser.c"
+import "C"
+
+import "unsafe"
+
+// Language returns the tree-sitter language for Cog, for use with
+// sitter.NewLanguage from github.com/tree-sitter/go-tree-sitter.
+func Language() unsafe.Pointer {
+	return unsafe.Pointer(C.tree_sitter_cog())
+}
@@ -0,0 +1,87 @@
+package tree_sitter_cog_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	sitter "github.com/tree-sitter/go-tree-sitter"
+
+	tree_sitter_cog "github.com/tree-sitter/tree-sitter-cog/bindings/go"
+)
+
+// TestParseCorpus parses every testdata/*.cog snippet and checks that its
+// S-expression matches the corresponding testdata/*.sexp file, the way the
+// svelte binding validates its tree with a real parse rather than just a
+// non-nil language check.
+func TestParseCorpus(t *testing.T) {
+	entries, err := os.ReadDir("testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parser := sitter.NewParser()
+	defer parser.Close()
+	if err := parser.SetLanguage(sitter.NewLanguage(tree_sitter_cog.Language())); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".cog") {
+			continue
+		}
+		base := strings.TrimSuffix(name, ".cog")
+
+		t.Run(base, func(t *testing.T) {
+			source, err := os.ReadFile(filepath.Join("testdata", name))
+			if err != nil {
+				t.Fatal(err)
+			}
+			wantBytes, err := os.ReadFile(filepath.Join("testdata", base+".sexp"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			want := strings.TrimSpace(string(wantBytes))
+
+			tree := parser.Parse(source, nil)
+			defer tree.Close()
+			got := strings.TrimSpace(tree.RootNode().ToSexp())
+
+			if got != want {
+				t.Errorf("tree mismatch for %s:\n%s", name, diffLines(want, got))
+			}
+		})
+	}
+}
+
+// diffLines renders a minimal unified-style diff between two strings, used
+// to make corpus test failures readable without pulling in a diff library.
+func diffLines(want, got string) string {
+	wantLines := strings.Split(want, "\n")
+	gotLines := strings.Split(got, "\n")
+
+	var b strings.Builder
+	for i := 0; i < len(wantLines) || i < len(gotLines); i++ {
+		var w, g string
+		if i < len(wantLines) {
+			w = wantLines[i]
+		}
+		if i < len(gotLines) {
+			g = gotLines[i]
+		}
+		if w == g {
+			fmt.Fprintf(&b, "  %s\n", w)
+			continue
+		}
+		if w != "" {
+			fmt.Fprintf(&b, "- %s\n", w)
+		}
+		if g != "" {
+			fmt.Fprintf(&b, "+ %s\n", g)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,44 @@
+package tree_sitter_cog
+
+import _ "embed"
+
+// The .scm files under queries/ are a generated copy of the canonical
+// ../../queries directory: go:embed patterns can't reach outside the
+// package directory or follow symlinks, so this package can't embed
+// them in place. Run `go generate` after editing ../../queries/*.scm;
+// TestEmbeddedQueriesMatchCanonicalSource fails the build if the two
+// drift.
+//
+//go:generate ./scripts/sync-queries.sh
+
+//go:embed queries/highlights.scm
+var highlightsQuery []byte
+
+//go:embed queries/injections.scm
+var injectionsQuery []byte
+
+//go:embed queries/locals.scm
+var localsQuery []byte
+
+//go:embed queries/indents.scm
+var indentsQuery []byte
+
+// Highlights returns the highlights.scm query for Cog.
+func Highlights() []byte {
+	return highlightsQuery
+}
+
+// Injections returns the injections.scm query for Cog.
+func Injections() []byte {
+	return injectionsQuery
+}
+
+// Locals returns the locals.scm query for Cog.
+func Locals() []byte {
+	return localsQuery
+}
+
+// Indents returns the indents.scm query for Cog.
+func Indents() []byte {
+	return indentsQuery
+}
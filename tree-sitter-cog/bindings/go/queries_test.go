@@ -0,0 +1,59 @@
+package tree_sitter_cog_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	sitter "github.com/tree-sitter/go-tree-sitter"
+
+	tree_sitter_cog "github.com/tree-sitter/tree-sitter-cog/bindings/go"
+)
+
+// TestQueriesCompile compiles each bundled query against the grammar so
+// that a node name broken by a grammar change is caught here instead of at
+// a consumer's query-load time.
+func TestQueriesCompile(t *testing.T) {
+	language := sitter.NewLanguage(tree_sitter_cog.Language())
+
+	queries := map[string][]byte{
+		"highlights.scm": tree_sitter_cog.Highlights(),
+		"injections.scm": tree_sitter_cog.Injections(),
+		"locals.scm":     tree_sitter_cog.Locals(),
+		"indents.scm":    tree_sitter_cog.Indents(),
+	}
+
+	for name, source := range queries {
+		t.Run(name, func(t *testing.T) {
+			query, err := sitter.NewQuery(language, string(source))
+			if err != nil {
+				t.Fatalf("compiling %s: %v", name, err)
+			}
+			defer query.Close()
+		})
+	}
+}
+
+// TestEmbeddedQueriesMatchCanonicalSource guards against the embedded
+// queries/*.scm copy drifting from the canonical ../../queries directory;
+// run `go generate` to refresh it after editing the canonical files.
+func TestEmbeddedQueriesMatchCanonicalSource(t *testing.T) {
+	embedded := map[string][]byte{
+		"highlights.scm": tree_sitter_cog.Highlights(),
+		"injections.scm": tree_sitter_cog.Injections(),
+		"locals.scm":     tree_sitter_cog.Locals(),
+		"indents.scm":    tree_sitter_cog.Indents(),
+	}
+
+	for name, got := range embedded {
+		t.Run(name, func(t *testing.T) {
+			want, err := os.ReadFile(filepath.Join("..", "..", "queries", name))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != string(want) {
+				t.Errorf("queries/%s is out of sync with ../../queries/%s; run `go generate`", name, name)
+			}
+		})
+	}
+}